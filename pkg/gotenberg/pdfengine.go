@@ -0,0 +1,49 @@
+package gotenberg
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// PdfFormats gathers the available PDF formats for converting a PDF
+// document to a specific PDF format.
+type PdfFormats struct {
+	// PdfA is the PDF/A format, e.g. PDF/A-1b.
+	PdfA string
+
+	// PdfUa specifies if the PDF should also be PDF/UA compliant.
+	PdfUa bool
+}
+
+// MergeEntry pairs a PDF file with the display name under which it should
+// appear in a merged document's outline, as produced by
+// [PdfEngine.MergeWithOutline].
+type MergeEntry struct {
+	// DisplayName is used as the title of the entry's top-level bookmark.
+	DisplayName string
+
+	// Path is the PDF file to merge.
+	Path string
+}
+
+// PdfEngine is a module interface that describes a component capable of
+// manipulating a PDF document.
+//
+// https://go-gotenberg.dev/docs/modules/gotenberg
+type PdfEngine interface {
+	// Merge merges the given PDFs into a single PDF, in the given order.
+	Merge(ctx context.Context, logger *zap.Logger, inputPaths []string, outputPath string) error
+
+	// MergeWithOutline merges the given entries into a single PDF, in the
+	// given order, inserting one top-level bookmark per entry pointing at
+	// that entry's first page. Bookmarks already present in an entry's PDF
+	// are kept as children of that entry's bookmark.
+	MergeWithOutline(ctx context.Context, logger *zap.Logger, entries []MergeEntry, outputPath string) error
+
+	// Convert converts the given PDF to the given PdfFormats.
+	Convert(ctx context.Context, logger *zap.Logger, formats PdfFormats, inputPath, outputPath string) error
+
+	// WriteMetadata writes the given metadata entries to the given PDF.
+	WriteMetadata(ctx context.Context, logger *zap.Logger, metadata map[string]interface{}, inputPath string) error
+}
@@ -0,0 +1,46 @@
+package gotenberg
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// RasterImageFormat is a file format a [Rasterizer] is able to produce for
+// each page it rasterizes.
+type RasterImageFormat string
+
+const (
+	RasterImageFormatPng  RasterImageFormat = "png"
+	RasterImageFormatJpeg RasterImageFormat = "jpeg"
+	RasterImageFormatWebp RasterImageFormat = "webp"
+)
+
+// RasterOptions gathers the available options when rasterizing a PDF to a
+// sequence of page images.
+type RasterOptions struct {
+	// Format is the image format of each rasterized page.
+	Format RasterImageFormat
+
+	// Dpi is the resolution, in dots per inch, used to rasterize each page.
+	Dpi int
+
+	// Quality is the JPEG/WebP export quality, between 1 and 100. Ignored
+	// for the PNG format.
+	Quality int
+
+	// PageRanges allows to select the range of pages to rasterize.
+	PageRanges string
+}
+
+// Rasterizer is a module interface that describes a component able to
+// render the pages of a PDF as a sequence of images (e.g. mutool,
+// pdftoppm).
+//
+// https://go-gotenberg.dev/docs/modules/gotenberg
+type Rasterizer interface {
+	// Rasterize renders each page of inputPath as an image inside
+	// outputDir, following the page-%04d.<ext> naming convention, and
+	// returns the resulting file paths, in page order.
+	Rasterize(ctx context.Context, logger *zap.Logger, inputPath, outputDir string, options RasterOptions) ([]string, error)
+}
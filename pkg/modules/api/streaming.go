@@ -0,0 +1,110 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"os"
+	"path/filepath"
+)
+
+// StreamingWriter incrementally writes the result of a batch conversion as
+// a multipart/mixed response, one part per finished input file, so that
+// clients may start processing early results while later files are still
+// converting.
+type StreamingWriter struct {
+	writer  *multipart.Writer
+	flusher http.Flusher
+}
+
+// NewStreamingWriter prepares w to receive a multipart/mixed response and
+// returns a [StreamingWriter] to incrementally write to it. It must be
+// closed once all parts have been written.
+func NewStreamingWriter(w http.ResponseWriter) *StreamingWriter {
+	mw := multipart.NewWriter(w)
+	w.Header().Set("Content-Type", fmt.Sprintf("multipart/mixed; boundary=%s", mw.Boundary()))
+	w.WriteHeader(http.StatusOK)
+
+	// w may not implement http.Flusher (e.g. in tests); flushing is then
+	// simply skipped, and parts are only pushed out once the handler
+	// returns and the response is fully written.
+	flusher, _ := w.(http.Flusher)
+
+	return &StreamingWriter{writer: mw, flusher: flusher}
+}
+
+// streamStatusEvent is the JSON header written alongside each part.
+type streamStatusEvent struct {
+	Input  string `json:"input"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// WriteResult writes a {"input":...,"status":"ok"} JSON part followed by the
+// contents of outputPath as a file part.
+func (s *StreamingWriter) WriteResult(input, outputPath string) error {
+	err := s.writeEvent(streamStatusEvent{Input: input, Status: "ok"})
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(outputPath)
+	if err != nil {
+		return fmt.Errorf("open '%s': %w", outputPath, err)
+	}
+	defer file.Close()
+
+	part, err := s.writer.CreateFormFile("file", filepath.Base(outputPath))
+	if err != nil {
+		return fmt.Errorf("create part for '%s': %w", outputPath, err)
+	}
+
+	_, err = io.Copy(part, file)
+	if err != nil {
+		return fmt.Errorf("copy '%s' to part: %w", outputPath, err)
+	}
+
+	s.flush()
+
+	return nil
+}
+
+// WriteError writes a {"input":...,"status":"error","error":...} JSON part
+// for an input file whose conversion failed.
+func (s *StreamingWriter) WriteError(input string, convertErr error) error {
+	err := s.writeEvent(streamStatusEvent{Input: input, Status: "error", Error: convertErr.Error()})
+	if err != nil {
+		return err
+	}
+
+	s.flush()
+
+	return nil
+}
+
+func (s *StreamingWriter) writeEvent(event streamStatusEvent) error {
+	part, err := s.writer.CreatePart(textproto.MIMEHeader{"Content-Type": {"application/json"}})
+	if err != nil {
+		return fmt.Errorf("create status part: %w", err)
+	}
+
+	return json.NewEncoder(part).Encode(event)
+}
+
+// flush pushes whatever has been written so far to the client right away,
+// instead of letting it sit in the response's internal write buffer until
+// the handler returns. Without it, streaming would still hold back early
+// results until the whole batch is done.
+func (s *StreamingWriter) flush() {
+	if s.flusher != nil {
+		s.flusher.Flush()
+	}
+}
+
+// Close finalizes the multipart response.
+func (s *StreamingWriter) Close() error {
+	return s.writer.Close()
+}
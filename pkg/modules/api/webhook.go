@@ -0,0 +1,140 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Header names recognized to enable the asynchronous, webhook-based flavor
+// of a route: instead of blocking until the conversion is done, the route
+// acknowledges the request immediately and later delivers the result (or an
+// error) to a client-provided URL.
+const (
+	WebhookUrlHeader              = "Gotenberg-Webhook-Url"
+	WebhookErrorUrlHeader         = "Gotenberg-Webhook-Error-Url"
+	WebhookMethodHeader           = "Gotenberg-Webhook-Method"
+	WebhookErrorMethodHeader      = "Gotenberg-Webhook-Error-Method"
+	WebhookExtraHttpHeadersHeader = "Gotenberg-Webhook-Extra-Http-Headers"
+)
+
+// WebhookJobIdHeader is set on the 204 No Content response of a
+// webhook-enabled request, so that the client can correlate the eventual
+// webhook callback with the request that triggered it.
+const WebhookJobIdHeader = "Gotenberg-Job-Id"
+
+// ErrInvalidWebhookMethod happens when a Gotenberg-Webhook-*-Method header
+// does not carry a method suitable for sending a body.
+var ErrInvalidWebhookMethod = errors.New("invalid webhook method")
+
+// ErrInvalidWebhookExtraHttpHeaders happens when the
+// Gotenberg-Webhook-Extra-Http-Headers header does not carry valid JSON.
+var ErrInvalidWebhookExtraHttpHeaders = errors.New("invalid webhook extra HTTP headers")
+
+// WebhookOptions gathers the information required to deliver the result of
+// an asynchronous conversion to a client-provided URL, as extracted from the
+// Gotenberg-Webhook-* headers of an incoming request.
+type WebhookOptions struct {
+	// Url is where the successful result of the conversion is POSTed.
+	Url string
+
+	// Method is the HTTP method used to call Url. Defaults to POST.
+	Method string
+
+	// ErrorUrl is where a JSON error body is sent if the conversion fails.
+	// Defaults to Url.
+	ErrorUrl string
+
+	// ErrorMethod is the HTTP method used to call ErrorUrl. Defaults to
+	// POST.
+	ErrorMethod string
+
+	// ExtraHttpHeaders are additional headers to add to both the result and
+	// the error requests.
+	ExtraHttpHeaders map[string]string
+}
+
+// ParseWebhookOptions extracts and validates the Gotenberg-Webhook-* headers
+// from an incoming request, so that other modules may enable webhook-based,
+// asynchronous responses. It returns (nil, nil) when no webhook URL has been
+// given, in which case the caller should fall back to its regular,
+// synchronous behavior.
+func ParseWebhookOptions(header http.Header) (*WebhookOptions, error) {
+	url := header.Get(WebhookUrlHeader)
+	if url == "" {
+		return nil, nil
+	}
+
+	options := &WebhookOptions{
+		Url:         url,
+		Method:      http.MethodPost,
+		ErrorUrl:    header.Get(WebhookErrorUrlHeader),
+		ErrorMethod: http.MethodPost,
+	}
+
+	if options.ErrorUrl == "" {
+		options.ErrorUrl = options.Url
+	}
+
+	if method := header.Get(WebhookMethodHeader); method != "" {
+		options.Method = method
+	}
+
+	if method := header.Get(WebhookErrorMethodHeader); method != "" {
+		options.ErrorMethod = method
+	}
+
+	for _, method := range []string{options.Method, options.ErrorMethod} {
+		switch method {
+		case http.MethodPost, http.MethodPut, http.MethodPatch:
+		default:
+			return nil, fmt.Errorf("method '%s': %w", method, ErrInvalidWebhookMethod)
+		}
+	}
+
+	if raw := header.Get(WebhookExtraHttpHeadersHeader); raw != "" {
+		err := json.Unmarshal([]byte(raw), &options.ExtraHttpHeaders)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal '%s': %w: %w", raw, err, ErrInvalidWebhookExtraHttpHeaders)
+		}
+	}
+
+	return options, nil
+}
+
+// webhookOptionsContextKey is the echo.Context key under which
+// WebhookMiddleware stashes the *WebhookOptions it parsed.
+const webhookOptionsContextKey = "webhookOptions"
+
+// WebhookMiddleware parses and validates the Gotenberg-Webhook-* headers of
+// an incoming request via ParseWebhookOptions, making the result available
+// to downstream handlers through WebhookOptionsFromContext. Any module
+// wanting to offer a webhook-based, asynchronous flavor of a route can
+// depend on this middleware instead of duplicating the header parsing.
+func WebhookMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		options, err := ParseWebhookOptions(c.Request().Header)
+		if err != nil {
+			return WrapError(
+				fmt.Errorf("parse webhook options: %w", err),
+				NewSentinelHttpError(http.StatusBadRequest, err.Error()),
+			)
+		}
+
+		c.Set(webhookOptionsContextKey, options)
+
+		return next(c)
+	}
+}
+
+// WebhookOptionsFromContext returns the *WebhookOptions stashed by
+// WebhookMiddleware, or nil if the middleware was not applied or the
+// request did not carry a Gotenberg-Webhook-Url header.
+func WebhookOptionsFromContext(c echo.Context) *WebhookOptions {
+	options, _ := c.Get(webhookOptionsContextKey).(*WebhookOptions)
+
+	return options
+}
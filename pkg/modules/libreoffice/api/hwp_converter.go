@@ -0,0 +1,29 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+)
+
+// ErrHwpBackendUnavailable happens when the requested HwpConverter backend
+// has not been registered, typically because its underlying binary could
+// not be found in $PATH at module init.
+var ErrHwpBackendUnavailable = errors.New("HWP backend unavailable")
+
+// HwpConverter is a module interface that describes a component able to
+// convert a HWP (Hangul Word Processor) document to DOCX.
+type HwpConverter interface {
+	// Name returns the unique identifier of the backend (e.g. "libreoffice",
+	// "pyhwp" or "hwp5odt"), as used by the `backend` form field of
+	// /forms/libreoffice/convert/docx.
+	Name() string
+
+	// Docx converts the given HWP file to DOCX.
+	Docx(ctx context.Context, logger *zap.Logger, inputPath, outputPath string) error
+}
+
+// HwpConverters is a registry of the HwpConverter backends that were found
+// available at module init, keyed by their Name.
+type HwpConverters map[string]HwpConverter
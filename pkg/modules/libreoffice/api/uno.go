@@ -0,0 +1,107 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/gotenberg/gotenberg/v8/pkg/gotenberg"
+)
+
+// ErrInvalidPdfFormats happens when the PdfFormats option cannot be
+// satisfied by the underlying LibreOffice instance.
+var ErrInvalidPdfFormats = errors.New("invalid PDF formats")
+
+// ErrMalformedPageRanges happens when the PageRanges option has a malformed
+// value.
+var ErrMalformedPageRanges = errors.New("malformed page ranges")
+
+// ErrInvalidImageOptions happens when an image-compression-related option
+// has a value outside its accepted range.
+var ErrInvalidImageOptions = errors.New("invalid image options")
+
+// allowedMaxImageResolutions are the only DPI values LibreOffice's export
+// filter accepts for its image resolution reduction setting.
+var allowedMaxImageResolutions = map[int]bool{
+	75:   true,
+	150:  true,
+	300:  true,
+	600:  true,
+	1200: true,
+}
+
+// Options gathers the available options when converting a document to PDF
+// with LibreOffice.
+type Options struct {
+	// Landscape allows to change the orientation of the resulting PDF to
+	// landscape.
+	Landscape bool
+
+	// PageRanges allows to select the range of pages to convert.
+	PageRanges string
+
+	// ExportFormFields specifies whether form fields are exported as
+	// widgets or only their fixed print representation is exported.
+	ExportFormFields bool
+
+	// SinglePageSheets ignores each sheet's defined print range and prints
+	// each sheet as a single page.
+	SinglePageSheets bool
+
+	// PdfFormats allows to convert the resulting PDF to a specific PDF
+	// format.
+	PdfFormats gotenberg.PdfFormats
+
+	// LosslessImageCompression specifies whether images are compressed
+	// using a lossless method instead of JPEG.
+	LosslessImageCompression bool
+
+	// Quality is the JPEG export quality of images, between 1 and 100.
+	// Ignored when LosslessImageCompression is true.
+	Quality int
+
+	// ReduceImageResolution specifies whether the resolution of images is
+	// capped to MaxImageResolution.
+	ReduceImageResolution bool
+
+	// MaxImageResolution is the maximum resolution, in DPI, of images
+	// inside the resulting PDF, when ReduceImageResolution is true. It
+	// must be one of 75, 150, 300, 600 or 1200.
+	MaxImageResolution int
+
+	// ExportNotesInMargin specifies whether notes are exported to the
+	// margin of the resulting PDF.
+	ExportNotesInMargin bool
+}
+
+// Validate makes sure the image-compression-related options are consistent,
+// so that callers may return a 400 Bad Request before invoking LibreOffice.
+func (o Options) Validate() error {
+	if o.Quality != 0 && (o.Quality < 1 || o.Quality > 100) {
+		return fmt.Errorf("quality %d is not between 1 and 100: %w", o.Quality, ErrInvalidImageOptions)
+	}
+
+	if o.ReduceImageResolution && !allowedMaxImageResolutions[o.MaxImageResolution] {
+		return fmt.Errorf("max image resolution %d is not one of 75, 150, 300, 600 or 1200: %w", o.MaxImageResolution, ErrInvalidImageOptions)
+	}
+
+	return nil
+}
+
+// Uno is a module interface that describes a component able to convert
+// documents to and from LibreOffice-supported formats via UNO.
+//
+// https://go-gotenberg.dev/docs/modules/libreoffice
+type Uno interface {
+	// Extensions returns the file extensions this converter is able to
+	// handle.
+	Extensions() []string
+
+	// Pdf converts the given file to PDF.
+	Pdf(ctx context.Context, logger *zap.Logger, inputPath, outputPath string, options Options) error
+
+	// Docx converts the given file to DOCX.
+	Docx(ctx context.Context, logger *zap.Logger, inputPath, outputPath string) error
+}
@@ -0,0 +1,62 @@
+package api
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestOptions_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		options Options
+		wantErr bool
+	}{
+		{
+			name:    "zero value is valid",
+			options: Options{},
+			wantErr: false,
+		},
+		{
+			name:    "quality in range",
+			options: Options{Quality: 90},
+			wantErr: false,
+		},
+		{
+			name:    "quality too low",
+			options: Options{Quality: -1},
+			wantErr: true,
+		},
+		{
+			name:    "quality too high",
+			options: Options{Quality: 101},
+			wantErr: true,
+		},
+		{
+			name:    "reduce image resolution with allowed DPI",
+			options: Options{ReduceImageResolution: true, MaxImageResolution: 300},
+			wantErr: false,
+		},
+		{
+			name:    "reduce image resolution with disallowed DPI",
+			options: Options{ReduceImageResolution: true, MaxImageResolution: 200},
+			wantErr: true,
+		},
+		{
+			name:    "max image resolution ignored when reduce is off",
+			options: Options{ReduceImageResolution: false, MaxImageResolution: 200},
+			wantErr: false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.options.Validate()
+
+			if tc.wantErr && !errors.Is(err, ErrInvalidImageOptions) {
+				t.Fatalf("Validate() = %v, want %v", err, ErrInvalidImageOptions)
+			}
+
+			if !tc.wantErr && err != nil {
+				t.Fatalf("Validate() = %v, want nil", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package libreoffice
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"go.uber.org/zap"
+
+	libreofficeapi "github.com/gotenberg/gotenberg/v8/pkg/modules/libreoffice/api"
+)
+
+// hwp5Signature and hwp3Signature are the header bytes used to tell apart
+// the binary HWP 5.0 (OLE2 compound file) format from the flat HWP 3.0
+// format, so that "auto" backend selection can pick the most faithful
+// converter for a given file.
+var (
+	hwp5Signature = []byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1}
+	hwp3Signature = []byte("HWP Document File")
+)
+
+// detectHwpVersion inspects the header of inputPath and returns "5.0",
+// "3.0" or "" when the signature is not recognized.
+//
+// hwp5Signature is only the generic CFBF/OLE2 magic number, also shared by
+// legacy .doc/.xls/.ppt files, so it is trusted only for inputs whose
+// extension already claims to be HWP; otherwise a legacy Office file would
+// be misdetected as HWP 5.0 and routed to pyhwp/hwp5odt instead of
+// LibreOffice.
+func detectHwpVersion(inputPath string) (string, error) {
+	file, err := os.Open(inputPath)
+	if err != nil {
+		return "", fmt.Errorf("open '%s': %w", inputPath, err)
+	}
+	defer file.Close()
+
+	header := make([]byte, 32)
+	n, err := file.Read(header)
+	if err != nil {
+		return "", fmt.Errorf("read header of '%s': %w", inputPath, err)
+	}
+	header = header[:n]
+
+	switch {
+	case bytes.HasPrefix(header, hwp5Signature) && strings.EqualFold(filepath.Ext(inputPath), ".hwp"):
+		return "5.0", nil
+	case bytes.HasPrefix(header, hwp3Signature):
+		return "3.0", nil
+	default:
+		return "", nil
+	}
+}
+
+// discoverHwpConverters probes $PATH for the binaries backing the
+// non-default HwpConverter backends and returns only those that are
+// actually available.
+func discoverHwpConverters() libreofficeapi.HwpConverters {
+	converters := make(libreofficeapi.HwpConverters)
+
+	if _, err := exec.LookPath("hwp5html"); err == nil {
+		if _, err := exec.LookPath("pandoc"); err == nil {
+			converters["pyhwp"] = pyhwpConverter{}
+		}
+	}
+
+	if _, err := exec.LookPath("hwp5odt"); err == nil {
+		if _, err := exec.LookPath("pandoc"); err == nil {
+			converters["hwp5odt"] = hwp5odtConverter{}
+		}
+	}
+
+	return converters
+}
+
+// unoHwpConverter adapts a [libreofficeapi.Uno] so that it can be used as
+// the default "libreoffice" HwpConverter backend.
+type unoHwpConverter struct {
+	uno libreofficeapi.Uno
+}
+
+func (c unoHwpConverter) Name() string { return "libreoffice" }
+
+func (c unoHwpConverter) Docx(ctx context.Context, logger *zap.Logger, inputPath, outputPath string) error {
+	return c.uno.Docx(ctx, logger, inputPath, outputPath)
+}
+
+// pyhwpConverter converts HWP 5.0 documents to DOCX by first extracting
+// their content to HTML via hwp5html, then letting pandoc produce the
+// DOCX, which tends to preserve Korean text and layout better than
+// LibreOffice's own HWP filter.
+type pyhwpConverter struct{}
+
+func (c pyhwpConverter) Name() string { return "pyhwp" }
+
+func (c pyhwpConverter) Docx(ctx context.Context, logger *zap.Logger, inputPath, outputPath string) error {
+	htmlPath := outputPath + ".html"
+	defer os.Remove(htmlPath)
+
+	cmd := exec.CommandContext(ctx, "hwp5html", "--output", htmlPath, inputPath)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("hwp5html '%s': %w: %s", inputPath, err, out)
+	}
+
+	cmd = exec.CommandContext(ctx, "pandoc", "-f", "html", "-t", "docx", "-o", outputPath, htmlPath)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("pandoc '%s': %w: %s", htmlPath, err, out)
+	}
+
+	return nil
+}
+
+// hwp5odtConverter converts HWP documents to DOCX via hwp5odt's ODT output,
+// then lets pandoc perform the final ODT -> DOCX step.
+type hwp5odtConverter struct{}
+
+func (c hwp5odtConverter) Name() string { return "hwp5odt" }
+
+func (c hwp5odtConverter) Docx(ctx context.Context, logger *zap.Logger, inputPath, outputPath string) error {
+	odtPath := outputPath + ".odt"
+	defer os.Remove(odtPath)
+
+	cmd := exec.CommandContext(ctx, "hwp5odt", "--output", odtPath, inputPath)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("hwp5odt '%s': %w: %s", inputPath, err, out)
+	}
+
+	cmd = exec.CommandContext(ctx, "pandoc", "-f", "odt", "-t", "docx", "-o", outputPath, odtPath)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("pandoc '%s': %w: %s", odtPath, err, out)
+	}
+
+	return nil
+}
+
+// resolveHwpConverter picks the HwpConverter backend to use for inputPath,
+// given the client-requested backend ("auto" by default). An explicitly
+// requested backend is returned as is, whatever the format of inputPath. In
+// "auto" mode, non-HWP documents go through the "libreoffice" backend, since
+// the alternative backends only know how to read the HWP format.
+func resolveHwpConverter(libreOffice libreofficeapi.Uno, converters libreofficeapi.HwpConverters, backend, inputPath string) (libreofficeapi.HwpConverter, error) {
+	all := libreofficeapi.HwpConverters{"libreoffice": unoHwpConverter{libreOffice}}
+	for name, converter := range converters {
+		all[name] = converter
+	}
+
+	if backend != "auto" {
+		converter, ok := all[backend]
+		if !ok {
+			return nil, fmt.Errorf("backend '%s': %w", backend, libreofficeapi.ErrHwpBackendUnavailable)
+		}
+
+		return converter, nil
+	}
+
+	version, err := detectHwpVersion(inputPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// pyhwp (via hwp5html) and hwp5odt only understand the binary HWP
+	// formats, so fall back to LibreOffice for anything else, or for older
+	// HWP 3.0 documents which they don't support.
+	preferred := []string{"libreoffice"}
+	if version == "5.0" {
+		preferred = []string{"pyhwp", "hwp5odt", "libreoffice"}
+	}
+
+	for _, name := range preferred {
+		if converter, ok := all[name]; ok {
+			return converter, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no HWP backend available: %w", libreofficeapi.ErrHwpBackendUnavailable)
+}
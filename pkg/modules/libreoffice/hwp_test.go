@@ -0,0 +1,147 @@
+package libreoffice
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/zap"
+
+	libreofficeapi "github.com/gotenberg/gotenberg/v8/pkg/modules/libreoffice/api"
+)
+
+func writeFile(t *testing.T, name string, content []byte) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, content, 0o644); err != nil {
+		t.Fatalf("write '%s': %s", path, err)
+	}
+
+	return path
+}
+
+func TestDetectHwpVersion(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		filename string
+		content  []byte
+		want     string
+	}{
+		{
+			name:     "HWP 5.0 signature with .hwp extension",
+			filename: "document.hwp",
+			content:  hwp5Signature,
+			want:     "5.0",
+		},
+		{
+			name:     "HWP 5.0 signature with .doc extension is not trusted",
+			filename: "document.doc",
+			content:  hwp5Signature,
+			want:     "",
+		},
+		{
+			name:     "HWP 3.0 signature regardless of extension",
+			filename: "document.bin",
+			content:  hwp3Signature,
+			want:     "3.0",
+		},
+		{
+			name:     "unrecognized content",
+			filename: "document.hwp",
+			content:  []byte("not a HWP file"),
+			want:     "",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			path := writeFile(t, tc.filename, tc.content)
+
+			version, err := detectHwpVersion(path)
+			if err != nil {
+				t.Fatalf("detectHwpVersion() error = %s", err)
+			}
+
+			if version != tc.want {
+				t.Fatalf("detectHwpVersion() = %q, want %q", version, tc.want)
+			}
+		})
+	}
+}
+
+// stubUno is a no-op libreofficeapi.Uno used to exercise resolveHwpConverter
+// without depending on a real LibreOffice installation.
+type stubUno struct{}
+
+func (stubUno) Extensions() []string { return []string{".hwp"} }
+
+func (stubUno) Pdf(context.Context, *zap.Logger, string, string, libreofficeapi.Options) error {
+	return nil
+}
+
+func (stubUno) Docx(context.Context, *zap.Logger, string, string) error { return nil }
+
+// stubHwpConverter is a named no-op libreofficeapi.HwpConverter.
+type stubHwpConverter struct{ name string }
+
+func (c stubHwpConverter) Name() string { return c.name }
+
+func (c stubHwpConverter) Docx(context.Context, *zap.Logger, string, string) error { return nil }
+
+func TestResolveHwpConverter(t *testing.T) {
+	converters := libreofficeapi.HwpConverters{
+		"pyhwp": stubHwpConverter{name: "pyhwp"},
+	}
+
+	t.Run("explicit backend", func(t *testing.T) {
+		converter, err := resolveHwpConverter(stubUno{}, converters, "pyhwp", writeFile(t, "a.hwp", hwp5Signature))
+		if err != nil {
+			t.Fatalf("resolveHwpConverter() error = %s", err)
+		}
+
+		if converter.Name() != "pyhwp" {
+			t.Fatalf("resolveHwpConverter() = %q, want %q", converter.Name(), "pyhwp")
+		}
+	})
+
+	t.Run("explicit unavailable backend", func(t *testing.T) {
+		_, err := resolveHwpConverter(stubUno{}, converters, "hwp5odt", writeFile(t, "a.hwp", hwp5Signature))
+		if !errors.Is(err, libreofficeapi.ErrHwpBackendUnavailable) {
+			t.Fatalf("resolveHwpConverter() error = %v, want %v", err, libreofficeapi.ErrHwpBackendUnavailable)
+		}
+	})
+
+	t.Run("auto prefers pyhwp for HWP 5.0", func(t *testing.T) {
+		converter, err := resolveHwpConverter(stubUno{}, converters, "auto", writeFile(t, "a.hwp", hwp5Signature))
+		if err != nil {
+			t.Fatalf("resolveHwpConverter() error = %s", err)
+		}
+
+		if converter.Name() != "pyhwp" {
+			t.Fatalf("resolveHwpConverter() = %q, want %q", converter.Name(), "pyhwp")
+		}
+	})
+
+	t.Run("auto falls back to libreoffice for HWP 3.0", func(t *testing.T) {
+		converter, err := resolveHwpConverter(stubUno{}, converters, "auto", writeFile(t, "a.hwp", hwp3Signature))
+		if err != nil {
+			t.Fatalf("resolveHwpConverter() error = %s", err)
+		}
+
+		if converter.Name() != "libreoffice" {
+			t.Fatalf("resolveHwpConverter() = %q, want %q", converter.Name(), "libreoffice")
+		}
+	})
+
+	t.Run("auto falls back to libreoffice for unrecognized content", func(t *testing.T) {
+		converter, err := resolveHwpConverter(stubUno{}, converters, "auto", writeFile(t, "a.hwp", []byte("plain text")))
+		if err != nil {
+			t.Fatalf("resolveHwpConverter() error = %s", err)
+		}
+
+		if converter.Name() != "libreoffice" {
+			t.Fatalf("resolveHwpConverter() = %q, want %q", converter.Name(), "libreoffice")
+		}
+	})
+}
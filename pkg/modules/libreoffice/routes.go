@@ -1,10 +1,15 @@
 package libreoffice
 
 import (
+	"archive/zip"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"os"
+	"path/filepath"
 
 	"github.com/labstack/echo/v4"
 
@@ -13,6 +18,45 @@ import (
 	libreofficeapi "github.com/gotenberg/gotenberg/v8/pkg/modules/libreoffice/api"
 )
 
+// pdfConversionOptions gathers the form fields that feed into a
+// libreofficeapi.Options, so that convertRoute's webhook, streaming and
+// synchronous code paths build it identically instead of each repeating
+// the same struct literal.
+type pdfConversionOptions struct {
+	landscape                bool
+	pageRanges               string
+	exportFormFields         bool
+	singlePageSheets         bool
+	losslessImageCompression bool
+	quality                  int
+	reduceImageResolution    bool
+	maxImageResolution       int
+	exportNotesInMargin      bool
+	pdfFormats               gotenberg.PdfFormats
+	nativePdfFormats         bool
+}
+
+// toUnoOptions builds the libreofficeapi.Options to pass to libreOffice.Pdf.
+func (o pdfConversionOptions) toUnoOptions() libreofficeapi.Options {
+	options := libreofficeapi.Options{
+		Landscape:                o.landscape,
+		PageRanges:               o.pageRanges,
+		ExportFormFields:         o.exportFormFields,
+		SinglePageSheets:         o.singlePageSheets,
+		LosslessImageCompression: o.losslessImageCompression,
+		Quality:                  o.quality,
+		ReduceImageResolution:    o.reduceImageResolution,
+		MaxImageResolution:       o.maxImageResolution,
+		ExportNotesInMargin:      o.exportNotesInMargin,
+	}
+
+	if o.nativePdfFormats {
+		options.PdfFormats = o.pdfFormats
+	}
+
+	return options
+}
+
 // convertRoute returns an [api.Route] which can convert LibreOffice documents
 // to PDF.
 func convertRoute(libreOffice libreofficeapi.Uno, engine gotenberg.PdfEngine) api.Route {
@@ -34,7 +78,15 @@ func convertRoute(libreOffice libreofficeapi.Uno, engine gotenberg.PdfEngine) ap
 				pdfua            bool
 				nativePdfFormats bool
 				merge            bool
+				mergeOutline     bool
 				metadata         map[string]interface{}
+				streamOutput     bool
+
+				losslessImageCompression bool
+				reduceImageResolution    bool
+				exportNotesInMargin      bool
+				quality                  int
+				maxImageResolution       int
 			)
 
 			err := ctx.FormData().
@@ -47,6 +99,13 @@ func convertRoute(libreOffice libreofficeapi.Uno, engine gotenberg.PdfEngine) ap
 				Bool("pdfua", &pdfua, false).
 				Bool("nativePdfFormats", &nativePdfFormats, true).
 				Bool("merge", &merge, false).
+				Bool("mergeOutline", &mergeOutline, false).
+				Bool("stream", &streamOutput, false).
+				Bool("losslessImageCompression", &losslessImageCompression, false).
+				Bool("reduceImageResolution", &reduceImageResolution, false).
+				Bool("exportNotesInMargin", &exportNotesInMargin, false).
+				Int("quality", &quality, 0).
+				Int("maxImageResolution", &maxImageResolution, 0).
 				Custom("metadata", func(value string) error {
 					if len(value) > 0 {
 						err := json.Unmarshal([]byte(value), &metadata)
@@ -61,90 +120,266 @@ func convertRoute(libreOffice libreofficeapi.Uno, engine gotenberg.PdfEngine) ap
 				return fmt.Errorf("validate form data: %w", err)
 			}
 
+			webhookOptions, err := api.ParseWebhookOptions(c.Request().Header)
+			if err != nil {
+				return api.WrapError(
+					fmt.Errorf("parse webhook options: %w", err),
+					api.NewSentinelHttpError(http.StatusBadRequest, err.Error()),
+				)
+			}
+
+			if webhookOptions != nil && len(inputPaths) != 1 {
+				return api.WrapError(
+					errors.New("webhook mode supports a single input file at a time"),
+					api.NewSentinelHttpError(http.StatusBadRequest, "Webhook mode (Gotenberg-Webhook-Url) supports a single input file at a time"),
+				)
+			}
+
+			if streamOutput && webhookOptions != nil {
+				return api.WrapError(
+					errors.New("stream is not compatible with webhook mode"),
+					api.NewSentinelHttpError(http.StatusBadRequest, "'stream' is not compatible with webhook mode (Gotenberg-Webhook-Url)"),
+				)
+			}
+
+			if streamOutput && merge {
+				return api.WrapError(
+					errors.New("stream is not compatible with merge"),
+					api.NewSentinelHttpError(http.StatusBadRequest, "'stream' is not compatible with 'merge'"),
+				)
+			}
+
 			pdfFormats := gotenberg.PdfFormats{
 				PdfA:  pdfa,
 				PdfUa: pdfua,
 			}
 
-			// Alright, let's convert each document to PDF.
-			outputPaths := make([]string, len(inputPaths))
-			for i, inputPath := range inputPaths {
-				outputPaths[i] = ctx.GeneratePath(".pdf")
-				options := libreofficeapi.Options{
-					Landscape:        landscape,
-					PageRanges:       nativePageRanges,
-					ExportFormFields: exportFormFields,
-					SinglePageSheets: singlePageSheets,
-				}
+			docOptions := pdfConversionOptions{
+				landscape:                landscape,
+				pageRanges:               nativePageRanges,
+				exportFormFields:         exportFormFields,
+				singlePageSheets:         singlePageSheets,
+				losslessImageCompression: losslessImageCompression,
+				quality:                  quality,
+				reduceImageResolution:    reduceImageResolution,
+				maxImageResolution:       maxImageResolution,
+				exportNotesInMargin:      exportNotesInMargin,
+				pdfFormats:               pdfFormats,
+				nativePdfFormats:         nativePdfFormats,
+			}
+
+			// When the client asked for a webhook-based response, validate
+			// the cheap, request-derived options synchronously (so that a
+			// bad request still gets an immediate 400), then acknowledge
+			// the request with its job id and run the conversion in the
+			// background, independently of this request's context and
+			// working directory.
+			if webhookOptions != nil {
+				options := docOptions.toUnoOptions()
 
-				if nativePdfFormats {
-					options.PdfFormats = pdfFormats
+				if err := options.Validate(); err != nil {
+					return api.WrapError(
+						fmt.Errorf("validate image options: %w", err),
+						api.NewSentinelHttpError(http.StatusBadRequest, err.Error()),
+					)
 				}
 
-				err = libreOffice.Pdf(ctx, ctx.Log(), inputPath, outputPaths[i], options)
-				if err != nil {
-					if errors.Is(err, libreofficeapi.ErrInvalidPdfFormats) {
-						return api.WrapError(
-							fmt.Errorf("convert to PDF: %w", err),
-							api.NewSentinelHttpError(
-								http.StatusBadRequest,
-								fmt.Sprintf("A PDF format in '%+v' is not supported", pdfFormats),
-							),
-						)
+				jobID := newWebhookJobID()
+				inputPath := inputPaths[0]
+
+				dispatchWebhookJob(ctx.Log(), webhookOptions, jobID, filepath.Base(inputPath)+".pdf", func(jobCtx context.Context, workdir string) (string, error) {
+					inputCopy, err := copyToDir(inputPath, workdir)
+					if err != nil {
+						return "", fmt.Errorf("copy input file: %w", err)
 					}
 
-					if errors.Is(err, libreofficeapi.ErrMalformedPageRanges) {
-						return api.WrapError(
-							fmt.Errorf("convert to PDF: %w", err),
-							api.NewSentinelHttpError(http.StatusBadRequest, fmt.Sprintf("Malformed page ranges '%s' (nativePageRanges)", options.PageRanges)),
-						)
+					outputPath := filepath.Join(workdir, "output.pdf")
+
+					err = libreOffice.Pdf(jobCtx, ctx.Log(), inputCopy, outputPath, options)
+					if err != nil {
+						return "", fmt.Errorf("convert to PDF: %w", err)
 					}
 
-					return fmt.Errorf("convert to PDF: %w", err)
-				}
+					zeroValued := gotenberg.PdfFormats{}
+					if !nativePdfFormats && pdfFormats != zeroValued {
+						convertedPath := filepath.Join(workdir, "converted.pdf")
+
+						err = engine.Convert(jobCtx, ctx.Log(), pdfFormats, outputPath, convertedPath)
+						if err != nil {
+							return "", fmt.Errorf("convert PDF: %w", err)
+						}
+
+						outputPath = convertedPath
+					}
+
+					if len(metadata) > 0 {
+						err = engine.WriteMetadata(jobCtx, ctx.Log(), metadata, outputPath)
+						if err != nil {
+							return "", fmt.Errorf("write metadata: %w", err)
+						}
+					}
+
+					return outputPath, nil
+				})
+
+				c.Response().Header().Set(api.WebhookJobIdHeader, jobID)
+
+				return c.NoContent(http.StatusNoContent)
 			}
 
-			// So far so good, let's check if we have to merge the PDFs.
-			if len(outputPaths) > 1 && merge {
-				outputPath := ctx.GeneratePath(".pdf")
+			// When the client asked for a streamed response, convert and
+			// flush each document as soon as it's ready instead of waiting
+			// for the whole batch, so that early results aren't held back
+			// by slower files.
+			if streamOutput {
+				writer := api.NewStreamingWriter(c.Response())
+				defer writer.Close()
+
+				for _, inputPath := range inputPaths {
+					outputPath := ctx.GeneratePath(".pdf")
+					options := docOptions.toUnoOptions()
+
+					if err := options.Validate(); err != nil {
+						_ = writer.WriteError(inputPath, err)
+						continue
+					}
+
+					if err := libreOffice.Pdf(ctx, ctx.Log(), inputPath, outputPath, options); err != nil {
+						_ = writer.WriteError(inputPath, err)
+						continue
+					}
+
+					zeroValued := gotenberg.PdfFormats{}
+					if !nativePdfFormats && pdfFormats != zeroValued {
+						convertedPath := ctx.GeneratePath(".pdf")
 
-				err = engine.Merge(ctx, ctx.Log(), outputPaths, outputPath)
-				if err != nil {
-					return fmt.Errorf("merge PDFs: %w", err)
+						if err := engine.Convert(ctx, ctx.Log(), pdfFormats, outputPath, convertedPath); err != nil {
+							_ = writer.WriteError(inputPath, err)
+							continue
+						}
+
+						outputPath = convertedPath
+					}
+
+					if len(metadata) > 0 {
+						if err := engine.WriteMetadata(ctx, ctx.Log(), metadata, outputPath); err != nil {
+							_ = writer.WriteError(inputPath, err)
+							continue
+						}
+					}
+
+					if err := writer.WriteResult(inputPath, outputPath); err != nil {
+						return fmt.Errorf("write streaming result: %w", err)
+					}
 				}
 
-				// Only one output path.
-				outputPaths = []string{outputPath}
+				return nil
 			}
 
-			// Let's check if the client want to convert each PDF to a specific
-			// PDF format.
-			zeroValued := gotenberg.PdfFormats{}
-			if !nativePdfFormats && pdfFormats != zeroValued {
-				convertOutputPaths := make([]string, len(outputPaths))
+			// convert runs the whole conversion pipeline (PDF conversion,
+			// merge, PDF format conversion and metadata) and returns the
+			// resulting output paths.
+			convert := func() ([]string, error) {
+				// Alright, let's convert each document to PDF.
+				outputPaths := make([]string, len(inputPaths))
+				for i, inputPath := range inputPaths {
+					outputPaths[i] = ctx.GeneratePath(".pdf")
+					options := docOptions.toUnoOptions()
 
-				for i, outputPath := range outputPaths {
-					convertInputPath := outputPath
-					convertOutputPaths[i] = ctx.GeneratePath(".pdf")
+					if err := options.Validate(); err != nil {
+						return nil, api.WrapError(
+							fmt.Errorf("validate image options: %w", err),
+							api.NewSentinelHttpError(http.StatusBadRequest, err.Error()),
+						)
+					}
 
-					err = engine.Convert(ctx, ctx.Log(), pdfFormats, convertInputPath, convertOutputPaths[i])
+					err := libreOffice.Pdf(ctx, ctx.Log(), inputPath, outputPaths[i], options)
 					if err != nil {
-						return fmt.Errorf("convert PDF: %w", err)
+						if errors.Is(err, libreofficeapi.ErrInvalidPdfFormats) {
+							return nil, api.WrapError(
+								fmt.Errorf("convert to PDF: %w", err),
+								api.NewSentinelHttpError(
+									http.StatusBadRequest,
+									fmt.Sprintf("A PDF format in '%+v' is not supported", pdfFormats),
+								),
+							)
+						}
+
+						if errors.Is(err, libreofficeapi.ErrMalformedPageRanges) {
+							return nil, api.WrapError(
+								fmt.Errorf("convert to PDF: %w", err),
+								api.NewSentinelHttpError(http.StatusBadRequest, fmt.Sprintf("Malformed page ranges '%s' (nativePageRanges)", options.PageRanges)),
+							)
+						}
+
+						return nil, fmt.Errorf("convert to PDF: %w", err)
 					}
 				}
 
-				// Important: the output paths are now the converted files.
-				outputPaths = convertOutputPaths
-			}
+				// So far so good, let's check if we have to merge the PDFs.
+				if len(outputPaths) > 1 && merge {
+					outputPath := ctx.GeneratePath(".pdf")
+
+					if mergeOutline {
+						entries := make([]gotenberg.MergeEntry, len(outputPaths))
+						for i, path := range outputPaths {
+							entries[i] = gotenberg.MergeEntry{
+								DisplayName: filepath.Base(inputPaths[i]),
+								Path:        path,
+							}
+						}
 
-			// Writes and potentially overrides metadata entries, if any.
-			if len(metadata) > 0 {
-				for _, outputPath := range outputPaths {
-					err = engine.WriteMetadata(ctx, ctx.Log(), metadata, outputPath)
-					if err != nil {
-						return fmt.Errorf("write metadata: %w", err)
+						err := engine.MergeWithOutline(ctx, ctx.Log(), entries, outputPath)
+						if err != nil {
+							return nil, fmt.Errorf("merge PDFs with outline: %w", err)
+						}
+					} else {
+						err := engine.Merge(ctx, ctx.Log(), outputPaths, outputPath)
+						if err != nil {
+							return nil, fmt.Errorf("merge PDFs: %w", err)
+						}
+					}
+
+					// Only one output path.
+					outputPaths = []string{outputPath}
+				}
+
+				// Let's check if the client want to convert each PDF to a specific
+				// PDF format.
+				zeroValued := gotenberg.PdfFormats{}
+				if !nativePdfFormats && pdfFormats != zeroValued {
+					convertOutputPaths := make([]string, len(outputPaths))
+
+					for i, outputPath := range outputPaths {
+						convertInputPath := outputPath
+						convertOutputPaths[i] = ctx.GeneratePath(".pdf")
+
+						err := engine.Convert(ctx, ctx.Log(), pdfFormats, convertInputPath, convertOutputPaths[i])
+						if err != nil {
+							return nil, fmt.Errorf("convert PDF: %w", err)
+						}
 					}
+
+					// Important: the output paths are now the converted files.
+					outputPaths = convertOutputPaths
 				}
+
+				// Writes and potentially overrides metadata entries, if any.
+				if len(metadata) > 0 {
+					for _, outputPath := range outputPaths {
+						err := engine.WriteMetadata(ctx, ctx.Log(), metadata, outputPath)
+						if err != nil {
+							return nil, fmt.Errorf("write metadata: %w", err)
+						}
+					}
+				}
+
+				return outputPaths, nil
+			}
+
+			outputPaths, err := convert()
+			if err != nil {
+				return err
 			}
 
 			if len(outputPaths) > 1 {
@@ -173,9 +408,169 @@ func convertRoute(libreOffice libreofficeapi.Uno, engine gotenberg.PdfEngine) ap
 	}
 }
 
+// convertRouteImages returns an [api.Route] which can convert LibreOffice
+// documents to a sequence of page images.
+func convertRouteImages(libreOffice libreofficeapi.Uno, rasterizer gotenberg.Rasterizer) api.Route {
+	return api.Route{
+		Method:      http.MethodPost,
+		Path:        "/forms/libreoffice/convert/images",
+		IsMultipart: true,
+		Handler: func(c echo.Context) error {
+			ctx := c.Get("context").(*api.Context)
+
+			// Let's get the data from the form and validate them.
+			var (
+				inputPaths []string
+				format     string
+				dpi        int
+				quality    int
+				pageRanges string
+				zipOutput  bool
+			)
+
+			err := ctx.FormData().
+				MandatoryPaths(libreOffice.Extensions(), &inputPaths).
+				String("format", &format, "png").
+				Int("dpi", &dpi, 150).
+				Int("quality", &quality, 90).
+				String("pageRanges", &pageRanges, "").
+				Bool("zip", &zipOutput, false).
+				Validate()
+			if err != nil {
+				return fmt.Errorf("validate form data: %w", err)
+			}
+
+			switch format {
+			case "png", "jpeg", "webp":
+			default:
+				return api.WrapError(
+					fmt.Errorf("validate form data: format '%s' is not supported", format),
+					api.NewSentinelHttpError(http.StatusBadRequest, fmt.Sprintf("Format '%s' is not supported (expected png, jpeg or webp)", format)),
+				)
+			}
+
+			if quality < 1 || quality > 100 {
+				return api.WrapError(
+					fmt.Errorf("validate form data: quality %d is not between 1 and 100", quality),
+					api.NewSentinelHttpError(http.StatusBadRequest, fmt.Sprintf("Quality %d is not between 1 and 100", quality)),
+				)
+			}
+
+			options := gotenberg.RasterOptions{
+				Format:     gotenberg.RasterImageFormat(format),
+				Dpi:        dpi,
+				Quality:    quality,
+				PageRanges: pageRanges,
+			}
+
+			// Alright, let's convert each document to PDF first, then
+			// rasterize it, mirroring how convertRoute plugs the PDF
+			// engine in after LibreOffice's own conversion.
+			var outputPaths []string
+			for _, inputPath := range inputPaths {
+				pdfPath := ctx.GeneratePath(".pdf")
+
+				err = libreOffice.Pdf(ctx, ctx.Log(), inputPath, pdfPath, libreofficeapi.Options{})
+				if err != nil {
+					return fmt.Errorf("convert to PDF: %w", err)
+				}
+
+				outputDir := ctx.GeneratePath("")
+
+				err = os.MkdirAll(outputDir, 0o755)
+				if err != nil {
+					return fmt.Errorf("create output dir: %w", err)
+				}
+
+				pagePaths, err := rasterizer.Rasterize(ctx, ctx.Log(), pdfPath, outputDir, options)
+				if err != nil {
+					if errors.Is(err, libreofficeapi.ErrMalformedPageRanges) {
+						return api.WrapError(
+							fmt.Errorf("rasterize: %w", err),
+							api.NewSentinelHttpError(http.StatusBadRequest, fmt.Sprintf("Malformed page ranges '%s' (pageRanges)", pageRanges)),
+						)
+					}
+
+					return fmt.Errorf("rasterize: %w", err)
+				}
+
+				outputPaths = append(outputPaths, pagePaths...)
+			}
+
+			// A single page from a single input document may be returned as
+			// is; everything else is bundled into a ZIP archive so that the
+			// client always knows what to expect from the response.
+			if zipOutput || len(outputPaths) > 1 {
+				archivePath := ctx.GeneratePath(".zip")
+
+				err = zipFiles(outputPaths, archivePath)
+				if err != nil {
+					return fmt.Errorf("zip output paths: %w", err)
+				}
+
+				outputPaths = []string{archivePath}
+			}
+
+			// Last but not least, add the output paths to the context so that
+			// the API is able to send them as a response to the client.
+			err = ctx.AddOutputPaths(outputPaths...)
+			if err != nil {
+				return fmt.Errorf("add output paths: %w", err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// zipFiles bundles paths into a single ZIP archive at archivePath. Entries
+// are named after their parent directory and base name (e.g.
+// "<outputDir>/page-0001.png") rather than just their base name, so that
+// pages rasterized from different source documents into their own
+// outputDir don't collide under an identical "page-NNNN.<ext>" name.
+func zipFiles(paths []string, archivePath string) error {
+	archive, err := os.Create(archivePath)
+	if err != nil {
+		return fmt.Errorf("create archive: %w", err)
+	}
+	defer archive.Close()
+
+	writer := zip.NewWriter(archive)
+	defer writer.Close()
+
+	for _, path := range paths {
+		err = func() error {
+			source, err := os.Open(path)
+			if err != nil {
+				return fmt.Errorf("open '%s': %w", path, err)
+			}
+			defer source.Close()
+
+			entryName := filepath.Join(filepath.Base(filepath.Dir(path)), filepath.Base(path))
+
+			entry, err := writer.Create(entryName)
+			if err != nil {
+				return fmt.Errorf("create entry for '%s': %w", path, err)
+			}
+
+			_, err = io.Copy(entry, source)
+			if err != nil {
+				return fmt.Errorf("copy '%s' to archive: %w", path, err)
+			}
+
+			return nil
+		}()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // convertRouteDocx returns an [api.Route] which can convert LibreOffice documents
 // to DOCX.
-func convertRouteDocx(libreOffice libreofficeapi.Uno) api.Route {
+func convertRouteDocx(libreOffice libreofficeapi.Uno, hwpConverters libreofficeapi.HwpConverters) api.Route {
 	return api.Route{
 		Method:      http.MethodPost,
 		Path:        "/forms/libreoffice/convert/docx",
@@ -185,31 +580,148 @@ func convertRouteDocx(libreOffice libreofficeapi.Uno) api.Route {
 
 			// Let's get the data from the form and validate them.
 			var (
-				inputPaths       []string
+				inputPaths   []string
+				backend      string
+				streamOutput bool
 			)
 
 			err := ctx.FormData().
 				MandatoryPaths(libreOffice.Extensions(), &inputPaths).
+				String("backend", &backend, "auto").
+				Bool("stream", &streamOutput, false).
 				Validate()
 			if err != nil {
 				return fmt.Errorf("validate form data: %w", err)
 			}
 
-			// Alright, let's convert each document to DOCX.
-			outputPaths := make([]string, len(inputPaths))
-			for i, inputPath := range inputPaths {
-				outputPaths[i] = ctx.GeneratePath(".docx")
+			switch backend {
+			case "auto", "libreoffice", "pyhwp", "hwp5odt":
+			default:
+				return api.WrapError(
+					fmt.Errorf("validate form data: backend '%s' is not supported", backend),
+					api.NewSentinelHttpError(http.StatusBadRequest, fmt.Sprintf("Backend '%s' is not supported (expected auto, libreoffice, pyhwp or hwp5odt)", backend)),
+				)
+			}
 
-				err = libreOffice.Docx(ctx, ctx.Log(), inputPath, outputPaths[i])
-				if err != nil {
-					return api.WrapError(
-						fmt.Errorf("convert to DOCX: %w", err),
-						api.NewSentinelHttpError(
-							http.StatusInternalServerError,
-							"Error",
-						),
-					)
+			webhookOptions, err := api.ParseWebhookOptions(c.Request().Header)
+			if err != nil {
+				return api.WrapError(
+					fmt.Errorf("parse webhook options: %w", err),
+					api.NewSentinelHttpError(http.StatusBadRequest, err.Error()),
+				)
+			}
+
+			if webhookOptions != nil && len(inputPaths) != 1 {
+				return api.WrapError(
+					errors.New("webhook mode supports a single input file at a time"),
+					api.NewSentinelHttpError(http.StatusBadRequest, "Webhook mode (Gotenberg-Webhook-Url) supports a single input file at a time"),
+				)
+			}
+
+			if streamOutput && webhookOptions != nil {
+				return api.WrapError(
+					errors.New("stream is not compatible with webhook mode"),
+					api.NewSentinelHttpError(http.StatusBadRequest, "'stream' is not compatible with webhook mode (Gotenberg-Webhook-Url)"),
+				)
+			}
+
+			// When the client asked for a webhook-based response,
+			// acknowledge the request with its job id and run the
+			// conversion in the background, independently of this
+			// request's context and working directory.
+			if webhookOptions != nil {
+				jobID := newWebhookJobID()
+				inputPath := inputPaths[0]
+
+				dispatchWebhookJob(ctx.Log(), webhookOptions, jobID, filepath.Base(inputPath)+".docx", func(jobCtx context.Context, workdir string) (string, error) {
+					inputCopy, err := copyToDir(inputPath, workdir)
+					if err != nil {
+						return "", fmt.Errorf("copy input file: %w", err)
+					}
+
+					converter, err := resolveHwpConverter(libreOffice, hwpConverters, backend, inputCopy)
+					if err != nil {
+						return "", fmt.Errorf("resolve HWP backend: %w", err)
+					}
+
+					outputPath := filepath.Join(workdir, "output.docx")
+
+					err = converter.Docx(jobCtx, ctx.Log(), inputCopy, outputPath)
+					if err != nil {
+						return "", fmt.Errorf("convert to DOCX: %w", err)
+					}
+
+					return outputPath, nil
+				})
+
+				c.Response().Header().Set(api.WebhookJobIdHeader, jobID)
+
+				return c.NoContent(http.StatusNoContent)
+			}
+
+			// When the client asked for a streamed response, convert and
+			// flush each document as soon as it's ready instead of waiting
+			// for the whole batch, so that early results aren't held back
+			// by slower files.
+			if streamOutput {
+				writer := api.NewStreamingWriter(c.Response())
+				defer writer.Close()
+
+				for _, inputPath := range inputPaths {
+					outputPath := ctx.GeneratePath(".docx")
+
+					converter, err := resolveHwpConverter(libreOffice, hwpConverters, backend, inputPath)
+					if err != nil {
+						_ = writer.WriteError(inputPath, err)
+						continue
+					}
+
+					if err := converter.Docx(ctx, ctx.Log(), inputPath, outputPath); err != nil {
+						_ = writer.WriteError(inputPath, err)
+						continue
+					}
+
+					if err := writer.WriteResult(inputPath, outputPath); err != nil {
+						return fmt.Errorf("write streaming result: %w", err)
+					}
+				}
+
+				return nil
+			}
+
+			// convert runs the DOCX conversion for every input path and
+			// returns the resulting output paths.
+			convert := func() ([]string, error) {
+				outputPaths := make([]string, len(inputPaths))
+				for i, inputPath := range inputPaths {
+					outputPaths[i] = ctx.GeneratePath(".docx")
+
+					converter, err := resolveHwpConverter(libreOffice, hwpConverters, backend, inputPath)
+					if err != nil {
+						return nil, api.WrapError(
+							fmt.Errorf("resolve HWP backend: %w", err),
+							api.NewSentinelHttpError(http.StatusServiceUnavailable, err.Error()),
+						)
+					}
+
+					err = converter.Docx(ctx, ctx.Log(), inputPath, outputPaths[i])
+					if err != nil {
+						return nil, api.WrapError(
+							fmt.Errorf("convert to DOCX: %w", err),
+							api.NewSentinelHttpError(
+								http.StatusInternalServerError,
+								"Error",
+							),
+						)
+					}
 				}
+
+				return outputPaths, nil
+			}
+
+			outputPaths, err := convert()
+			if err != nil {
+				return err
 			}
 
 			if len(outputPaths) > 1 {
@@ -225,7 +737,7 @@ func convertRouteDocx(libreOffice libreofficeapi.Uno) api.Route {
 					outputPaths[i] = outputPath
 				}
 			}
-			
+
 			// Last but not least, add the output paths to the context so that
 			// the API is able to send them as a response to the client.
 			err = ctx.AddOutputPaths(outputPaths...)
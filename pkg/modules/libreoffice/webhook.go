@@ -0,0 +1,221 @@
+package libreoffice
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/gotenberg/gotenberg/v8/pkg/modules/api"
+)
+
+// webhookJobPool bounds the number of conversions running in the background
+// on behalf of webhook-enabled requests, so that a burst of asynchronous
+// jobs cannot starve the synchronous routes.
+var webhookJobPool = make(chan struct{}, 10)
+
+// webhookJobTimeout bounds how long a single background conversion may run
+// for, since it is no longer tied to the lifetime of the HTTP request that
+// triggered it.
+const webhookJobTimeout = 30 * time.Minute
+
+// webhookRetryBackoff is the delay waited before each webhook delivery
+// attempt, the first one included; it holds one entry per attempt.
+var webhookRetryBackoff = []time.Duration{0, time.Second, 5 * time.Second}
+
+// webhookDeliveryTimeout bounds how long a single webhook delivery attempt
+// may take, so that a slow or unresponsive client-provided URL cannot hang
+// the goroutine (and its webhookJobPool slot) indefinitely.
+const webhookDeliveryTimeout = 30 * time.Second
+
+// newWebhookJobID generates an identifier clients can use to correlate a
+// webhook-enabled request with the callback it later receives.
+func newWebhookJobID() string {
+	buf := make([]byte, 16)
+	_, _ = rand.Read(buf)
+
+	return hex.EncodeToString(buf)
+}
+
+// dispatchWebhookJob runs convert in the background and delivers its result
+// (the path to the resulting file) or its error to the client-provided
+// webhook URLs. outputFilename is the name under which the result is sent
+// as a multipart file part.
+//
+// Unlike the request that triggered it, this job must not depend on the
+// request's own context.Context (canceled as soon as the handler returns)
+// or its working directory (cleaned up around the same time). convert is
+// therefore handed its own, independently-timed context.Context along with
+// a dedicated working directory that outlives the request; it is
+// responsible for copying in whatever input files it needs.
+func dispatchWebhookJob(logger *zap.Logger, options *api.WebhookOptions, jobID, outputFilename string, convert func(ctx context.Context, workdir string) (string, error)) {
+	go func() {
+		// Acquired here, on the background goroutine, so that a full pool
+		// never blocks the HTTP handler that dispatched this job.
+		webhookJobPool <- struct{}{}
+		defer func() { <-webhookJobPool }()
+
+		workdir, err := os.MkdirTemp("", "gotenberg-webhook-*")
+		if err != nil {
+			logger.Error(fmt.Sprintf("webhook job '%s' failed: %s", jobID, err))
+			sendWebhookError(logger, options, err)
+			return
+		}
+		defer os.RemoveAll(workdir)
+
+		jobCtx, cancel := context.WithTimeout(context.Background(), webhookJobTimeout)
+		defer cancel()
+
+		outputPath, err := convert(jobCtx, workdir)
+		if err != nil {
+			logger.Error(fmt.Sprintf("webhook job '%s' failed: %s", jobID, err))
+			sendWebhookError(logger, options, err)
+			return
+		}
+
+		sendWebhookResult(logger, options, outputPath, outputFilename)
+	}()
+}
+
+// copyToDir copies src into dir, preserving its extension, and returns the
+// new path. It lets a webhook job keep working with its input after the
+// request's own working directory has been cleaned up.
+func copyToDir(src, dir string) (string, error) {
+	source, err := os.Open(src)
+	if err != nil {
+		return "", fmt.Errorf("open '%s': %w", src, err)
+	}
+	defer source.Close()
+
+	dst := filepath.Join(dir, "input"+filepath.Ext(src))
+
+	destination, err := os.Create(dst)
+	if err != nil {
+		return "", fmt.Errorf("create '%s': %w", dst, err)
+	}
+	defer destination.Close()
+
+	_, err = io.Copy(destination, source)
+	if err != nil {
+		return "", fmt.Errorf("copy '%s' to '%s': %w", src, dst, err)
+	}
+
+	return dst, nil
+}
+
+// sendWebhookResult POSTs (or PUTs/PATCHes) outputPath as a multipart file
+// part to options.Url, retrying with backoff on failure.
+func sendWebhookResult(logger *zap.Logger, options *api.WebhookOptions, outputPath, outputFilename string) {
+	buildRequest := func(ctx context.Context) (*http.Request, error) {
+		file, err := os.Open(outputPath)
+		if err != nil {
+			return nil, fmt.Errorf("open output file: %w", err)
+		}
+		defer file.Close()
+
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+
+		part, err := writer.CreateFormFile("file", outputFilename)
+		if err != nil {
+			return nil, fmt.Errorf("create form file: %w", err)
+		}
+
+		_, err = io.Copy(part, file)
+		if err != nil {
+			return nil, fmt.Errorf("copy output file: %w", err)
+		}
+
+		err = writer.Close()
+		if err != nil {
+			return nil, fmt.Errorf("close multipart writer: %w", err)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, options.Method, options.Url, body)
+		if err != nil {
+			return nil, fmt.Errorf("create request: %w", err)
+		}
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		req.Header.Set("Gotenberg-Job-Filename", filepath.Base(outputFilename))
+
+		return req, nil
+	}
+
+	err := sendWebhookRequest(buildRequest, options.ExtraHttpHeaders)
+	if err != nil {
+		logger.Error(fmt.Sprintf("send webhook result to '%s': %s", options.Url, err))
+	}
+}
+
+// sendWebhookError POSTs (or PUTs/PATCHes) a JSON error body to
+// options.ErrorUrl, retrying with backoff on failure.
+func sendWebhookError(logger *zap.Logger, options *api.WebhookOptions, jobErr error) {
+	payload, _ := json.Marshal(struct {
+		Error string `json:"error"`
+	}{Error: jobErr.Error()})
+
+	buildRequest := func(ctx context.Context) (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, options.ErrorMethod, options.ErrorUrl, bytes.NewReader(payload))
+		if err != nil {
+			return nil, fmt.Errorf("create request: %w", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		return req, nil
+	}
+
+	err := sendWebhookRequest(buildRequest, options.ExtraHttpHeaders)
+	if err != nil {
+		logger.Error(fmt.Sprintf("send webhook error to '%s': %s", options.ErrorUrl, err))
+	}
+}
+
+// sendWebhookRequest builds and sends an HTTP request via buildRequest,
+// retrying according to webhookRetryBackoff until it gets a non-5xx
+// response. Each attempt is bound to webhookDeliveryTimeout, so a slow or
+// unresponsive webhook URL cannot hang the calling goroutine indefinitely.
+func sendWebhookRequest(buildRequest func(ctx context.Context) (*http.Request, error), extraHttpHeaders map[string]string) error {
+	var lastErr error
+
+	for _, delay := range webhookRetryBackoff {
+		time.Sleep(delay)
+
+		ctx, cancel := context.WithTimeout(context.Background(), webhookDeliveryTimeout)
+
+		req, err := buildRequest(ctx)
+		if err != nil {
+			cancel()
+			return err
+		}
+
+		for key, value := range extraHttpHeaders {
+			req.Header.Set(key, value)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		cancel()
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode < http.StatusInternalServerError {
+			return nil
+		}
+
+		lastErr = fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return lastErr
+}